@@ -0,0 +1,49 @@
+// Package interceptor holds the grpc interceptors the coordinator installs on
+// every inbound RPC, regardless of which handler ultimately serves it.
+package interceptor
+
+import (
+	"context"
+	"runtime/debug"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// PanicRecoveryUnaryServerInterceptor turns a panic in a unary handler into an
+// Internal grpc error instead of taking down the whole coordinator process.
+func PanicRecoveryUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("panic recovered in unary rpc handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.String("stack", string(debug.Stack())))
+				err = status.Errorf(codes.Internal, "panic recovered: %v", r)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// PanicRecoveryStreamServerInterceptor is the streaming-rpc counterpart of
+// PanicRecoveryUnaryServerInterceptor.
+func PanicRecoveryStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Error("panic recovered in stream rpc handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.String("stack", string(debug.Stack())))
+				err = status.Errorf(codes.Internal, "panic recovered: %v", r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
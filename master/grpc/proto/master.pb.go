@@ -1,281 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// source: master.proto
-
-package master
-
-import (
-	context "context"
-	fmt "fmt"
-	proto "github.com/golang/protobuf/proto"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-	math "math"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
-
-// The request message containing the user's name.
-type Request struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *Request) Reset()         { *m = Request{} }
-func (m *Request) String() string { return proto.CompactTextString(m) }
-func (*Request) ProtoMessage()    {}
-func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{0}
-}
-
-func (m *Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Request.Unmarshal(m, b)
-}
-func (m *Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Request.Marshal(b, m, deterministic)
-}
-func (m *Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Request.Merge(m, src)
-}
-func (m *Request) XXX_Size() int {
-	return xxx_messageInfo_Request.Size(m)
-}
-func (m *Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_Request.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Request proto.InternalMessageInfo
-
-func (m *Request) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
-
-type EmptyRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *EmptyRequest) Reset()         { *m = EmptyRequest{} }
-func (m *EmptyRequest) String() string { return proto.CompactTextString(m) }
-func (*EmptyRequest) ProtoMessage()    {}
-func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{1}
-}
-
-func (m *EmptyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EmptyRequest.Unmarshal(m, b)
-}
-func (m *EmptyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EmptyRequest.Marshal(b, m, deterministic)
-}
-func (m *EmptyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EmptyRequest.Merge(m, src)
-}
-func (m *EmptyRequest) XXX_Size() int {
-	return xxx_messageInfo_EmptyRequest.Size(m)
-}
-func (m *EmptyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EmptyRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EmptyRequest proto.InternalMessageInfo
-
-// The response message containing the greetings
-type Reply struct {
-	Status               bool     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *Reply) Reset()         { *m = Reply{} }
-func (m *Reply) String() string { return proto.CompactTextString(m) }
-func (*Reply) ProtoMessage()    {}
-func (*Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f9c348dec43a6705, []int{2}
-}
-
-func (m *Reply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Reply.Unmarshal(m, b)
-}
-func (m *Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Reply.Marshal(b, m, deterministic)
-}
-func (m *Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Reply.Merge(m, src)
-}
-func (m *Reply) XXX_Size() int {
-	return xxx_messageInfo_Reply.Size(m)
-}
-func (m *Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_Reply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Reply proto.InternalMessageInfo
-
-func (m *Reply) GetStatus() bool {
-	if m != nil {
-		return m.Status
-	}
-	return false
-}
-
-func init() {
-	proto.RegisterType((*Request)(nil), "master.Request")
-	proto.RegisterType((*EmptyRequest)(nil), "master.EmptyRequest")
-	proto.RegisterType((*Reply)(nil), "master.Reply")
-}
-
-func init() { proto.RegisterFile("master.proto", fileDescriptor_f9c348dec43a6705) }
-
-var fileDescriptor_f9c348dec43a6705 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xc9, 0x4d, 0x2c, 0x2e,
-	0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xf0, 0x94, 0x94, 0xb9, 0xd8,
-	0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0x24, 0xb8, 0xd8, 0x13, 0x53, 0x52, 0x8a, 0x52,
-	0x8b, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x60, 0x5c, 0x25, 0x3e, 0x2e, 0x1e, 0xd7,
-	0xdc, 0x82, 0x92, 0x4a, 0xa8, 0x4a, 0x25, 0x79, 0x2e, 0xd6, 0xa0, 0xd4, 0x82, 0x9c, 0x4a, 0x21,
-	0x31, 0x2e, 0xb6, 0xe2, 0x92, 0xc4, 0x92, 0x52, 0x88, 0x0e, 0x8e, 0x20, 0x28, 0xcf, 0xa8, 0x98,
-	0x8b, 0xdd, 0xbd, 0x28, 0x35, 0xb5, 0x24, 0xb5, 0x48, 0xc8, 0x90, 0x8b, 0x37, 0x28, 0xb5, 0x20,
-	0xbf, 0xa8, 0xc4, 0x11, 0x62, 0x98, 0x10, 0xbf, 0x1e, 0xd4, 0x21, 0x50, 0xd3, 0xa4, 0x78, 0x11,
-	0x02, 0x05, 0x39, 0x95, 0x4a, 0x0c, 0x42, 0xa6, 0x5c, 0x5c, 0xee, 0xa9, 0x70, 0xf5, 0x22, 0x30,
-	0x69, 0x64, 0x27, 0x48, 0xa1, 0x9b, 0xa2, 0xc4, 0xe0, 0xa4, 0xcd, 0x25, 0x04, 0x15, 0x4b, 0x2f,
-	0x2a, 0x48, 0x86, 0xca, 0x3b, 0x71, 0xfb, 0x82, 0xe9, 0x00, 0x90, 0xaf, 0x03, 0x18, 0xa3, 0xa0,
-	0xfe, 0x4e, 0x62, 0x03, 0x07, 0x83, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xe4, 0x39, 0x2c,
-	0x16, 0x01, 0x00, 0x00,
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// GreeterClient is the client API for Greeter service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GreeterClient interface {
-	// receive a grpc_server address and set to etcd.
-	ReportAddress(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Reply, error)
-	// send a grpc_server address to client
-	GetAddress(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Request, error)
-}
-
-type greeterClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewGreeterClient(cc *grpc.ClientConn) GreeterClient {
-	return &greeterClient{cc}
-}
-
-func (c *greeterClient) ReportAddress(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Reply, error) {
-	out := new(Reply)
-	err := c.cc.Invoke(ctx, "/master.Greeter/ReportAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *greeterClient) GetAddress(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Request, error) {
-	out := new(Request)
-	err := c.cc.Invoke(ctx, "/master.Greeter/GetAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// GreeterServer is the server API for Greeter service.
-type GreeterServer interface {
-	// receive a grpc_server address and set to etcd.
-	ReportAddress(context.Context, *Request) (*Reply, error)
-	// send a grpc_server address to client
-	GetAddress(context.Context, *EmptyRequest) (*Request, error)
-}
-
-// UnimplementedGreeterServer can be embedded to have forward compatible implementations.
-type UnimplementedGreeterServer struct {
-}
-
-func (*UnimplementedGreeterServer) ReportAddress(ctx context.Context, req *Request) (*Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportAddress not implemented")
-}
-func (*UnimplementedGreeterServer) GetAddress(ctx context.Context, req *EmptyRequest) (*Request, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAddress not implemented")
-}
-
-func RegisterGreeterServer(s *grpc.Server, srv GreeterServer) {
-	s.RegisterService(&_Greeter_serviceDesc, srv)
-}
-
-func _Greeter_ReportAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GreeterServer).ReportAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/master.Greeter/ReportAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).ReportAddress(ctx, req.(*Request))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Greeter_GetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GreeterServer).GetAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/master.Greeter/GetAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GreeterServer).GetAddress(ctx, req.(*EmptyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Greeter_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "master.Greeter",
-	HandlerType: (*GreeterServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ReportAddress",
-			Handler:    _Greeter_ReportAddress_Handler,
-		},
-		{
-			MethodName: "GetAddress",
-			Handler:    _Greeter_GetAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "master.proto",
-}
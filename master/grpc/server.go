@@ -0,0 +1,172 @@
+// Package grpc wires the Coordinator grpc service: node registration,
+// heartbeats and health checks used by k8s/consul-style liveness and
+// readiness probes.
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/google/uuid"
+	"github.com/milvus-io/milvus/master/grpc/interceptor"
+	masterpb "github.com/milvus-io/milvus/master/grpc/proto"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// nodeRegistryPrefix is the etcd key prefix a registered node's address and
+// metadata live under, keyed by the UUID the coordinator assigned the node in
+// RegisterNode rather than its raw address, so a node that restarts with a new
+// address doesn't leak a stale entry behind its old one.
+const nodeRegistryPrefix = "master/nodes/"
+
+// nodeLeaseTTLSeconds bounds how long a registered node's etcd entry survives
+// without a heartbeat; Heartbeat keeps the lease alive, so a node that dies
+// ungracefully (crashes instead of calling Deregister) has its entry expire
+// on its own instead of leaking forever.
+const nodeLeaseTTLSeconds = 30
+
+// registeredNode tracks the etcd lease backing a node's registration so
+// Heartbeat can keep it alive and Deregister/expiry can release it.
+type registeredNode struct {
+	leaseID clientv3.LeaseID
+}
+
+// Server implements masterpb.CoordinatorServer, backing node registration and
+// liveness for the cluster with etcd.
+type Server struct {
+	masterpb.UnimplementedCoordinatorServer
+
+	etcdCli    *clientv3.Client
+	healthSrv  *health.Server
+	grpcServer *grpc.Server
+	authSecret string
+
+	mu    sync.Mutex
+	nodes map[string]registeredNode // node id -> lease, for Heartbeat keep-alive and rejection
+}
+
+// NewServer builds a Coordinator server backed by etcdCli. authSecret, when
+// non-empty, is the HS256 shared secret intra-cluster RPCs must present.
+func NewServer(etcdCli *clientv3.Client, authSecret string) *Server {
+	return &Server{
+		etcdCli:    etcdCli,
+		healthSrv:  health.NewServer(),
+		authSecret: authSecret,
+		nodes:      make(map[string]registeredNode),
+	}
+}
+
+// RegisterGRPC attaches the Coordinator and standard health services to srv.
+// It installs no interceptors; srv must be built with ServerOptions for the
+// panic-recovery and optional JWT auth interceptors to apply.
+func (s *Server) RegisterGRPC(srv *grpc.Server) {
+	masterpb.RegisterCoordinatorServer(srv, s)
+	healthpb.RegisterHealthServer(srv, s.healthSrv)
+	s.healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+}
+
+// ServerOptions returns the interceptor chain every Coordinator grpc.Server
+// should be constructed with.
+func ServerOptions(authSecret string) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			interceptor.PanicRecoveryUnaryServerInterceptor(),
+			interceptor.JWTAuthUnaryServerInterceptor(authSecret),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptor.PanicRecoveryStreamServerInterceptor(),
+			interceptor.JWTAuthStreamServerInterceptor(authSecret),
+		),
+	}
+}
+
+func (s *Server) RegisterNode(ctx context.Context, req *masterpb.RegisterNodeRequest) (*masterpb.RegisterNodeResponse, error) {
+	nodeID := uuid.NewString()
+
+	lease, err := s.etcdCli.Grant(ctx, nodeLeaseTTLSeconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant etcd lease for node: %w", err)
+	}
+
+	key := nodeRegistryPrefix + nodeID
+	value := fmt.Sprintf("%s|%s|%s|%s", req.GetAddress(), req.GetNodeType().String(), req.GetVersion(), req.GetGitCommit())
+	if _, err := s.etcdCli.Put(ctx, key, value, clientv3.WithLease(lease.ID)); err != nil {
+		return nil, fmt.Errorf("failed to register node in etcd: %w", err)
+	}
+
+	s.mu.Lock()
+	s.nodes[nodeID] = registeredNode{leaseID: lease.ID}
+	s.mu.Unlock()
+
+	log.Info("node registered",
+		zap.String("nodeID", nodeID),
+		zap.String("address", req.GetAddress()),
+		zap.String("nodeType", req.GetNodeType().String()),
+		zap.Strings("capabilities", req.GetCapabilities()))
+
+	return &masterpb.RegisterNodeResponse{NodeId: nodeID}, nil
+}
+
+func (s *Server) Heartbeat(stream masterpb.Coordinator_HeartbeatServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		s.mu.Lock()
+		node, ok := s.nodes[req.GetNodeId()]
+		s.mu.Unlock()
+
+		if ok {
+			// Renew the node's etcd lease so it only expires when heartbeats
+			// actually stop, e.g. because the node crashed without calling
+			// Deregister, instead of on a fixed wall-clock TTL.
+			if _, err := s.etcdCli.KeepAliveOnce(stream.Context(), node.leaseID); err != nil {
+				log.Warn("failed to renew node lease, dropping node", zap.String("nodeID", req.GetNodeId()), zap.Error(err))
+				ok = false
+
+				// The lease is already gone (expired/revoked out from under
+				// us), so keep trying to renew it on later heartbeats would
+				// never succeed; drop the entry now instead of leaking it
+				// until the node happens to call Deregister, which a node
+				// that crashed never will.
+				s.mu.Lock()
+				delete(s.nodes, req.GetNodeId())
+				s.mu.Unlock()
+			}
+		}
+
+		if err := stream.Send(&masterpb.HeartbeatResponse{Ok: ok}); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) Deregister(ctx context.Context, req *masterpb.DeregisterRequest) (*masterpb.DeregisterResponse, error) {
+	s.mu.Lock()
+	node, ok := s.nodes[req.GetNodeId()]
+	delete(s.nodes, req.GetNodeId())
+	s.mu.Unlock()
+
+	if ok {
+		if _, err := s.etcdCli.Revoke(ctx, node.leaseID); err != nil {
+			return nil, fmt.Errorf("failed to revoke node lease: %w", err)
+		}
+	}
+
+	log.Info("node deregistered", zap.String("nodeID", req.GetNodeId()))
+	return &masterpb.DeregisterResponse{}, nil
+}
@@ -2,16 +2,40 @@ package indexparamcheck
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
+const (
+	// H3ResolutionKey is the required index param naming the single H3 resolution
+	// at which a geospatial field is indexed.
+	H3ResolutionKey = "h3_resolution"
+	// H3ResolutionsKey optionally names a comma-separated list of additional H3
+	// resolutions to build, turning the index into a hierarchical, multi-resolution
+	// cover: coarse cells prune candidate segments, finer cells refine the result.
+	H3ResolutionsKey = "h3_resolutions"
+
+	// h3MinResolution and h3MaxResolution bound the resolutions H3 itself supports.
+	h3MinResolution = 0
+	h3MaxResolution = 15
+
+	// h3MaxResolutionCount bounds how many resolutions may be configured for a
+	// single field so the combined index size stays bounded.
+	h3MaxResolutionCount = 4
+)
+
 type H3Checker struct {
 	scalarIndexChecker
 }
 
 func (c *H3Checker) CheckTrain(params map[string]string) error {
+	if _, err := parseH3Resolutions(params); err != nil {
+		return err
+	}
 	return c.scalarIndexChecker.CheckTrain(params)
 }
 
@@ -25,3 +49,79 @@ func (c *H3Checker) CheckValidDataType(field *schemapb.FieldSchema) error {
 func newH3Checker() *H3Checker {
 	return &H3Checker{}
 }
+
+// parseH3Resolutions validates the h3_resolution/h3_resolutions params and returns
+// the sorted, de-duplicated set of resolutions the index should be built at. The
+// mandatory h3_resolution value is always included. It rejects duplicate/overlapping
+// resolutions and more than h3MaxResolutionCount resolutions in total.
+func parseH3Resolutions(params map[string]string) ([]int, error) {
+	rawResolution, ok := params[H3ResolutionKey]
+	if !ok {
+		return nil, fmt.Errorf("%s not found, H3 index param must contain %s", H3ResolutionKey, H3ResolutionKey)
+	}
+	resolution, err := parseH3Resolution(rawResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[int]struct{}{resolution: {}}
+	resolutions := []int{resolution}
+
+	if rawResolutions, ok := params[H3ResolutionsKey]; ok && len(rawResolutions) > 0 {
+		for _, tok := range strings.Split(rawResolutions, ",") {
+			r, err := parseH3Resolution(strings.TrimSpace(tok))
+			if err != nil {
+				return nil, err
+			}
+			if _, dup := seen[r]; dup {
+				return nil, fmt.Errorf("%s contains duplicate/overlapping resolution %d", H3ResolutionsKey, r)
+			}
+			seen[r] = struct{}{}
+			resolutions = append(resolutions, r)
+		}
+	}
+
+	if len(resolutions) > h3MaxResolutionCount {
+		return nil, fmt.Errorf("H3 index supports at most %d resolutions, got %d", h3MaxResolutionCount, len(resolutions))
+	}
+
+	sort.Ints(resolutions)
+	return resolutions, nil
+}
+
+func parseH3Resolution(raw string) (int, error) {
+	resolution, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse H3 resolution %s: %s", raw, err)
+	}
+	if resolution < h3MinResolution || resolution > h3MaxResolution {
+		return 0, fmt.Errorf("H3 resolution must be in range [%d, %d], got %d", h3MinResolution, h3MaxResolution, resolution)
+	}
+	return resolution, nil
+}
+
+// avgHexAreaKm2 is H3's average hexagon area per resolution, in square kilometers.
+// Each resolution subdivides the previous one into ~7 children, so average area
+// shrinks by roughly a factor of 7 per level.
+var avgHexAreaKm2 = [h3MaxResolution + 1]float64{
+	4.25e6, 6.08e5, 8.68e4, 1.24e4, 1.77e3, 2.52e2, 3.60e1,
+	5.14e0, 7.34e-1, 1.05e-1, 1.50e-2, 2.14e-3, 3.06e-4, 4.37e-5, 6.24e-6, 8.93e-7,
+}
+
+// PickH3Resolution chooses the coarsest configured resolution expected to cover a
+// query polygon of area areaKm2 with roughly targetCells H3 cells, preferring the
+// cheapest resolution that still prunes effectively and falling back to the
+// finest configured resolution to refine when no coarser one suffices.
+//
+// resolutions must be sorted ascending, as returned by parseH3Resolutions.
+func PickH3Resolution(resolutions []int, areaKm2 float64, targetCells float64) int {
+	if len(resolutions) == 0 {
+		return -1
+	}
+	for _, r := range resolutions {
+		if avgHexAreaKm2[r]*targetCells >= areaKm2 {
+			return r
+		}
+	}
+	return resolutions[len(resolutions)-1]
+}
@@ -0,0 +1,102 @@
+package indexparamcheck
+
+import (
+	"testing"
+)
+
+func TestParseH3Resolutions(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  map[string]string
+		want    []int
+		wantErr bool
+	}{
+		{
+			name:    "missing required key",
+			params:  map[string]string{},
+			wantErr: true,
+		},
+		{
+			name:   "single resolution",
+			params: map[string]string{H3ResolutionKey: "9"},
+			want:   []int{9},
+		},
+		{
+			name:   "merges and sorts h3_resolutions",
+			params: map[string]string{H3ResolutionKey: "9", H3ResolutionsKey: "5, 3"},
+			want:   []int{3, 5, 9},
+		},
+		{
+			name:    "duplicate resolution rejected",
+			params:  map[string]string{H3ResolutionKey: "9", H3ResolutionsKey: "9"},
+			wantErr: true,
+		},
+		{
+			name:    "out of range resolution rejected",
+			params:  map[string]string{H3ResolutionKey: "16"},
+			wantErr: true,
+		},
+		{
+			name:   "max resolution count boundary allowed",
+			params: map[string]string{H3ResolutionKey: "0", H3ResolutionsKey: "1,2,3"},
+			want:   []int{0, 1, 2, 3},
+		},
+		{
+			name:    "max resolution count boundary exceeded",
+			params:  map[string]string{H3ResolutionKey: "0", H3ResolutionsKey: "1,2,3,4"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseH3Resolutions(tt.params)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPickH3Resolution(t *testing.T) {
+	resolutions := []int{3, 6, 9}
+
+	t.Run("picks coarsest resolution that covers the area", func(t *testing.T) {
+		// Resolution 3's average hex covers the whole area with plenty of
+		// margin, so it should win over the finer resolutions.
+		got := PickH3Resolution(resolutions, 1.0, 1.0)
+		if got != 3 {
+			t.Fatalf("got %d, want 3", got)
+		}
+	})
+
+	t.Run("falls back to finest resolution when no coarser one covers the area", func(t *testing.T) {
+		// An area far larger than even the coarsest resolution's hex can
+		// cover should fall back to the finest configured resolution.
+		got := PickH3Resolution(resolutions, 1e12, 1.0)
+		if got != 9 {
+			t.Fatalf("got %d, want 9", got)
+		}
+	})
+
+	t.Run("empty resolutions", func(t *testing.T) {
+		got := PickH3Resolution(nil, 1.0, 1.0)
+		if got != -1 {
+			t.Fatalf("got %d, want -1", got)
+		}
+	})
+}
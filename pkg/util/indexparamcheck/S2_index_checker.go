@@ -0,0 +1,111 @@
+package indexparamcheck
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// S2MinLevelKey and S2MaxLevelKey bound the S2 cell levels used to cover a
+	// geospatial field; S2MaxCellsKey bounds how many cells a cover may use.
+	S2MinLevelKey = "s2_min_level"
+	S2MaxLevelKey = "s2_max_level"
+	S2MaxCellsKey = "s2_max_cells"
+
+	// s2MinLevel and s2MaxLevel bound the levels S2 itself supports.
+	s2MinLevel = 0
+	s2MaxLevel = 30
+
+	// s2DefaultMaxCells is used when s2_max_cells is not set.
+	s2DefaultMaxCells = 8
+	// s2MaxMaxCells bounds s2_max_cells so a single cover can't blow up index size.
+	s2MaxMaxCells = 64
+)
+
+// S2Checker validates index params for a Google S2 cell-covering index on
+// geospatial fields. It is an alternative to H3Checker for workloads that need
+// rectangular-cover geometry, e.g. lat/lng bounding-box queries near the poles
+// where H3's hexagonal cells distort.
+type S2Checker struct {
+	scalarIndexChecker
+}
+
+// CheckTrain validates the s2_min_level/s2_max_level/s2_max_cells params. If
+// s2_max_cells is not set, CheckTrain writes s2DefaultMaxCells into params
+// under S2MaxCellsKey so the applied default is persisted with the rest of
+// the trained index config instead of being silently assumed; it leaves
+// params untouched when s2_max_cells is already set, so calling CheckTrain
+// again on the same params is a no-op.
+func (c *S2Checker) CheckTrain(params map[string]string) error {
+	minLevel, ok := params[S2MinLevelKey]
+	if !ok {
+		return fmt.Errorf("%s not found, S2 index param must contain %s", S2MinLevelKey, S2MinLevelKey)
+	}
+	maxLevel, ok := params[S2MaxLevelKey]
+	if !ok {
+		return fmt.Errorf("%s not found, S2 index param must contain %s", S2MaxLevelKey, S2MaxLevelKey)
+	}
+
+	min, err := parseS2Level(S2MinLevelKey, minLevel)
+	if err != nil {
+		return err
+	}
+	max, err := parseS2Level(S2MaxLevelKey, maxLevel)
+	if err != nil {
+		return err
+	}
+	if min > max {
+		return fmt.Errorf("%s (%d) must not be greater than %s (%d)", S2MinLevelKey, min, S2MaxLevelKey, max)
+	}
+
+	if _, err := applyS2MaxCellsDefault(params); err != nil {
+		return err
+	}
+
+	return c.scalarIndexChecker.CheckTrain(params)
+}
+
+// applyS2MaxCellsDefault reads s2_max_cells from params, writing in
+// s2DefaultMaxCells under S2MaxCellsKey first if it isn't set, and returns the
+// validated value. Re-running it on the same params is a no-op once
+// s2_max_cells is set, so repeated CheckTrain calls never double-apply it.
+func applyS2MaxCellsDefault(params map[string]string) (int, error) {
+	rawMaxCells, ok := params[S2MaxCellsKey]
+	if !ok {
+		rawMaxCells = strconv.Itoa(s2DefaultMaxCells)
+		params[S2MaxCellsKey] = rawMaxCells
+	}
+	maxCells, err := strconv.Atoi(rawMaxCells)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s %s: %s", S2MaxCellsKey, rawMaxCells, err)
+	}
+	if maxCells <= 0 || maxCells > s2MaxMaxCells {
+		return 0, fmt.Errorf("%s must be in range (0, %d], got %d", S2MaxCellsKey, s2MaxMaxCells, maxCells)
+	}
+	return maxCells, nil
+}
+
+func (c *S2Checker) CheckValidDataType(field *schemapb.FieldSchema) error {
+	if !typeutil.IsGeospatialType(field.GetDataType()) {
+		return fmt.Errorf("S2 are only supported on geospatial field")
+	}
+	return nil
+}
+
+func parseS2Level(key, raw string) (int, error) {
+	level, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s %s: %s", key, raw, err)
+	}
+	if level < s2MinLevel || level > s2MaxLevel {
+		return 0, fmt.Errorf("%s must be in range [%d, %d], got %d", key, s2MinLevel, s2MaxLevel, level)
+	}
+	return level, nil
+}
+
+func newS2Checker() *S2Checker {
+	return &S2Checker{}
+}
@@ -0,0 +1,54 @@
+package indexparamcheck
+
+import "testing"
+
+func TestApplyS2MaxCellsDefault(t *testing.T) {
+	t.Run("persists default when unset", func(t *testing.T) {
+		params := map[string]string{}
+		got, err := applyS2MaxCellsDefault(params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != s2DefaultMaxCells {
+			t.Fatalf("got %d, want %d", got, s2DefaultMaxCells)
+		}
+		if params[S2MaxCellsKey] != "8" {
+			t.Fatalf("default not persisted into params, got %q", params[S2MaxCellsKey])
+		}
+	})
+
+	t.Run("does not double-apply on repeated calls", func(t *testing.T) {
+		params := map[string]string{}
+		if _, err := applyS2MaxCellsDefault(params); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		first := params[S2MaxCellsKey]
+		if _, err := applyS2MaxCellsDefault(params); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if params[S2MaxCellsKey] != first {
+			t.Fatalf("s2_max_cells changed on repeated call: %q -> %q", first, params[S2MaxCellsKey])
+		}
+	})
+
+	t.Run("leaves explicit value untouched", func(t *testing.T) {
+		params := map[string]string{S2MaxCellsKey: "16"}
+		got, err := applyS2MaxCellsDefault(params)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got != 16 {
+			t.Fatalf("got %d, want 16", got)
+		}
+		if params[S2MaxCellsKey] != "16" {
+			t.Fatalf("explicit value overwritten: %q", params[S2MaxCellsKey])
+		}
+	})
+
+	t.Run("rejects out of range value", func(t *testing.T) {
+		params := map[string]string{S2MaxCellsKey: "0"}
+		if _, err := applyS2MaxCellsDefault(params); err == nil {
+			t.Fatalf("expected error, got none")
+		}
+	})
+}